@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/redhat-developer/devfile-parser/pkg/devfile/generator"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// runAll prints a Deployment, a Service and an Ingress for the devfile, in
+// that order, as either a single "---"-separated YAML stream or one JSON
+// document per line.
+func runAll(args []string) error {
+	fs := flag.NewFlagSet("all", flag.ExitOnError)
+	name := fs.String("name", "", "name used for the generated Deployment, Service and Ingress")
+	labels := fs.String("labels", "", "comma-separated key=value labels, e.g. app=foo,tier=web")
+	host := fs.String("host", "", "Go text/template used to derive each endpoint's host")
+	tlsSecret := fs.String("tls-secret", "", "name of the TLS secret to use for the generated hosts")
+	output := fs.String("output", "yaml", "output format: yaml|json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errMissingDevfileArg("all")
+	}
+
+	devfileObj, err := loadDevfile(fs.Arg(0), true)
+	if err != nil {
+		return err
+	}
+
+	selectorLabels, err := parseLabels(*labels)
+	if err != nil {
+		return err
+	}
+
+	deployment, err := generator.GetDeployment(devfileObj, generator.DeploymentParams{
+		ObjectMeta:        metav1.ObjectMeta{Name: *name, Labels: selectorLabels},
+		PodSelectorLabels: selectorLabels,
+	})
+	if err != nil {
+		return err
+	}
+	deployment.TypeMeta = metav1.TypeMeta{Kind: "Deployment", APIVersion: appsv1.SchemeGroupVersion.String()}
+
+	service, err := generator.GetService(devfileObj, generator.ServiceParams{
+		ObjectMeta:     metav1.ObjectMeta{Name: *name, Labels: selectorLabels},
+		SelectorLabels: selectorLabels,
+	})
+	if err != nil {
+		return err
+	}
+	service.TypeMeta = metav1.TypeMeta{Kind: "Service", APIVersion: corev1.SchemeGroupVersion.String()}
+
+	ingress, err := generator.GetIngress(devfileObj, generator.IngressParams{
+		ObjectMeta:    metav1.ObjectMeta{Name: *name},
+		TLSSecretName: *tlsSecret,
+		HostTemplate:  *host,
+	})
+	if err != nil {
+		return err
+	}
+	ingress.TypeMeta = metav1.TypeMeta{Kind: "Ingress", APIVersion: networkingv1.SchemeGroupVersion.String()}
+
+	return writeMultiOutput([]interface{}{deployment, service, ingress}, *output)
+}
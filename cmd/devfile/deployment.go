@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/redhat-developer/devfile-parser/pkg/devfile/generator"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func runDeployment(args []string) error {
+	fs := flag.NewFlagSet("deployment", flag.ExitOnError)
+	name := fs.String("name", "", "name of the generated Deployment")
+	labels := fs.String("labels", "", "comma-separated key=value labels, e.g. app=foo,tier=web")
+	output := fs.String("output", "yaml", "output format: yaml|json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errMissingDevfileArg("deployment")
+	}
+
+	devfileObj, err := loadDevfile(fs.Arg(0), true)
+	if err != nil {
+		return err
+	}
+
+	selectorLabels, err := parseLabels(*labels)
+	if err != nil {
+		return err
+	}
+
+	deployment, err := generator.GetDeployment(devfileObj, generator.DeploymentParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   *name,
+			Labels: selectorLabels,
+		},
+		PodSelectorLabels: selectorLabels,
+	})
+	if err != nil {
+		return err
+	}
+	deployment.TypeMeta = metav1.TypeMeta{Kind: "Deployment", APIVersion: appsv1.SchemeGroupVersion.String()}
+
+	return writeOutput(deployment, *output)
+}
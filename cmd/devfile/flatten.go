@@ -0,0 +1,23 @@
+package main
+
+import (
+	"flag"
+)
+
+func runFlatten(args []string) error {
+	fs := flag.NewFlagSet("flatten", flag.ExitOnError)
+	output := fs.String("output", "yaml", "output format: yaml|json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errMissingDevfileArg("flatten")
+	}
+
+	devfileObj, err := loadDevfile(fs.Arg(0), true)
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(devfileObj.Data, *output)
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/redhat-developer/devfile-parser/pkg/devfile/generator"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func runIngress(args []string) error {
+	fs := flag.NewFlagSet("ingress", flag.ExitOnError)
+	name := fs.String("name", "", "name of the generated Ingress, and of the Service it routes to")
+	host := fs.String("host", "", "Go text/template used to derive each endpoint's host, e.g. {{.EndpointName}}-{{.ComponentName}}.example.com")
+	ingressClass := fs.String("ingress-class", "", "ingress class name")
+	tlsSecret := fs.String("tls-secret", "", "name of the TLS secret to use for the generated hosts")
+	output := fs.String("output", "yaml", "output format: yaml|json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errMissingDevfileArg("ingress")
+	}
+
+	devfileObj, err := loadDevfile(fs.Arg(0), true)
+	if err != nil {
+		return err
+	}
+
+	ingress, err := generator.GetIngress(devfileObj, generator.IngressParams{
+		ObjectMeta:       metav1.ObjectMeta{Name: *name},
+		IngressClassName: *ingressClass,
+		TLSSecretName:    *tlsSecret,
+		HostTemplate:     *host,
+	})
+	if err != nil {
+		return err
+	}
+	ingress.TypeMeta = metav1.TypeMeta{Kind: "Ingress", APIVersion: networkingv1.SchemeGroupVersion.String()}
+
+	return writeOutput(ingress, *output)
+}
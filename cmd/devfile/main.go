@@ -0,0 +1,56 @@
+// Command devfile wraps the devfile-parser library so that CI pipelines and
+// non-Go tooling can parse and generate Kubernetes manifests from a devfile
+// without embedding the Go module.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "flatten":
+		err = runFlatten(os.Args[2:])
+	case "deployment":
+		err = runDeployment(os.Args[2:])
+	case "service":
+		err = runService(os.Args[2:])
+	case "ingress":
+		err = runIngress(os.Args[2:])
+	case "all":
+		err = runAll(os.Args[2:])
+	case "-h", "--help", "help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "devfile: unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(1)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "devfile: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `Usage: devfile <command> [flags] <devfile>
+
+Commands:
+  flatten <devfile>                       print the merged (parent/plugin-resolved) devfile as YAML
+  deployment --name --labels <devfile>    print a Deployment manifest
+  service <devfile>                       print a Service manifest
+  ingress --host --tls-secret <devfile>   print an Ingress manifest
+  all <devfile>                           print a multi-document Deployment+Service+Ingress YAML
+
+Flags must come before <devfile>; arguments after <devfile> are not parsed as flags.
+<devfile> may be a local path or a URL.`)
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/redhat-developer/devfile-parser/pkg/devfile/generator"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func runService(args []string) error {
+	fs := flag.NewFlagSet("service", flag.ExitOnError)
+	name := fs.String("name", "", "name of the generated Service")
+	labels := fs.String("labels", "", "comma-separated key=value labels, e.g. app=foo,tier=web")
+	output := fs.String("output", "yaml", "output format: yaml|json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errMissingDevfileArg("service")
+	}
+
+	devfileObj, err := loadDevfile(fs.Arg(0), true)
+	if err != nil {
+		return err
+	}
+
+	selectorLabels, err := parseLabels(*labels)
+	if err != nil {
+		return err
+	}
+
+	service, err := generator.GetService(devfileObj, generator.ServiceParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   *name,
+			Labels: selectorLabels,
+		},
+		SelectorLabels: selectorLabels,
+	})
+	if err != nil {
+		return err
+	}
+	service.TypeMeta = metav1.TypeMeta{Kind: "Service", APIVersion: corev1.SchemeGroupVersion.String()}
+
+	return writeOutput(service, *output)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/redhat-developer/devfile-parser/pkg/devfile/parser"
+	"sigs.k8s.io/yaml"
+)
+
+// loadDevfile parses a devfile from a local path or a URL, picking the
+// parsing function based on whether the source looks like a URL.
+func loadDevfile(source string, flatten bool) (parser.DevfileObj, error) {
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		return parser.ParseFromURL(source)
+	}
+	if flatten {
+		return parser.Parse(source)
+	}
+	return parser.ParseRawDevfile(source)
+}
+
+// writeOutput marshals obj as YAML or JSON and writes it to stdout.
+func writeOutput(obj interface{}, output string) error {
+	var out []byte
+	var err error
+	switch output {
+	case "", "yaml":
+		out, err = yaml.Marshal(obj)
+	case "json":
+		out, err = yaml.Marshal(obj)
+		if err == nil {
+			out, err = yaml.YAMLToJSON(out)
+		}
+	default:
+		return fmt.Errorf("unsupported --output %q, must be yaml or json", output)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
+
+// writeMultiOutput writes objs to stdout as either a "---"-separated YAML
+// stream or, for json, one compact JSON document per line.
+func writeMultiOutput(objs []interface{}, output string) error {
+	switch output {
+	case "", "yaml":
+		for _, obj := range objs {
+			out, err := yaml.Marshal(obj)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintln(os.Stdout, "---"); err != nil {
+				return err
+			}
+			if _, err := os.Stdout.Write(out); err != nil {
+				return err
+			}
+		}
+	case "json":
+		for _, obj := range objs {
+			out, err := yaml.Marshal(obj)
+			if err != nil {
+				return err
+			}
+			out, err = yaml.YAMLToJSON(out)
+			if err != nil {
+				return err
+			}
+			if _, err := os.Stdout.Write(out); err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintln(os.Stdout); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported --output %q, must be yaml or json", output)
+	}
+	return nil
+}
+
+// errMissingDevfileArg builds the error returned when a subcommand isn't given
+// exactly one positional <devfile> argument.
+func errMissingDevfileArg(command string) error {
+	return fmt.Errorf("usage: devfile %s [flags] <devfile>", command)
+}
+
+// parseLabels parses a comma-separated key=value list, e.g. "app=foo,tier=web".
+func parseLabels(labels string) (map[string]string, error) {
+	result := map[string]string{}
+	if labels == "" {
+		return result, nil
+	}
+	for _, pair := range strings.Split(labels, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid label %q, expected key=value", pair)
+		}
+		result[parts[0]] = parts[1]
+	}
+	return result, nil
+}
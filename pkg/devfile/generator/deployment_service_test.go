@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"testing"
+
+	v1 "github.com/devfile/api/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	"github.com/redhat-developer/devfile-parser/pkg/devfile/parser"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newDeploymentServiceTestDevfileObj(t *testing.T, components []v1.Component) parser.DevfileObj {
+	t.Helper()
+
+	devfileData, err := data.NewDevfileData("2.2.0")
+	if err != nil {
+		t.Fatalf("failed to create devfile data: %v", err)
+	}
+	if err := devfileData.AddComponents(components); err != nil {
+		t.Fatalf("failed to add components: %v", err)
+	}
+
+	return parser.DevfileObj{Data: devfileData}
+}
+
+func publicRuntimeContainerComponent() v1.Component {
+	return v1.Component{
+		Name: "runtime",
+		ComponentUnion: v1.ComponentUnion{
+			Container: &v1.ContainerComponent{
+				Container: v1.Container{
+					Image:   "quay.io/test/image:latest",
+					Command: []string{"/bin/sh"},
+					Endpoints: []v1.Endpoint{
+						{
+							Name:       "http",
+							TargetPort: 8080,
+							Exposure:   v1.Public,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetDeployment(t *testing.T) {
+	devfileObj := newDeploymentServiceTestDevfileObj(t, []v1.Component{publicRuntimeContainerComponent()})
+
+	deployment, err := GetDeployment(devfileObj, DeploymentParams{
+		ObjectMeta:        metav1.ObjectMeta{Name: "my-app"},
+		PodSelectorLabels: map[string]string{"app": "my-app"},
+	})
+	if err != nil {
+		t.Fatalf("GetDeployment() unexpected error: %v", err)
+	}
+
+	if got := len(deployment.Spec.Template.Spec.Containers); got != 1 {
+		t.Fatalf("GetDeployment() got %d containers, want 1", got)
+	}
+
+	container := deployment.Spec.Template.Spec.Containers[0]
+	if container.Name != "runtime" {
+		t.Errorf("GetDeployment() container name = %q, want %q", container.Name, "runtime")
+	}
+	if container.Image != "quay.io/test/image:latest" {
+		t.Errorf("GetDeployment() container image = %q, want %q", container.Image, "quay.io/test/image:latest")
+	}
+	if deployment.Spec.Selector.MatchLabels["app"] != "my-app" {
+		t.Errorf("GetDeployment() selector labels = %v, want app=my-app", deployment.Spec.Selector.MatchLabels)
+	}
+}
+
+func TestGetService(t *testing.T) {
+	devfileObj := newDeploymentServiceTestDevfileObj(t, []v1.Component{publicRuntimeContainerComponent()})
+
+	service, err := GetService(devfileObj, ServiceParams{
+		ObjectMeta:     metav1.ObjectMeta{Name: "my-app"},
+		SelectorLabels: map[string]string{"app": "my-app"},
+	})
+	if err != nil {
+		t.Fatalf("GetService() unexpected error: %v", err)
+	}
+
+	if got := len(service.Spec.Ports); got != 1 {
+		t.Fatalf("GetService() got %d ports, want 1", got)
+	}
+	if service.Spec.Ports[0].Port != 8080 {
+		t.Errorf("GetService() port = %d, want 8080", service.Spec.Ports[0].Port)
+	}
+	if service.Spec.Selector["app"] != "my-app" {
+		t.Errorf("GetService() selector = %v, want app=my-app", service.Spec.Selector)
+	}
+}
@@ -0,0 +1,201 @@
+package generator
+
+import (
+	"bytes"
+	"text/template"
+
+	v1 "github.com/devfile/api/pkg/apis/workspaces/v1alpha2"
+	"github.com/redhat-developer/devfile-parser/pkg/devfile/parser"
+	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeploymentParams is a struct that contains the required data to create a deployment object
+type DeploymentParams struct {
+	TypeMeta          metav1.TypeMeta
+	ObjectMeta        metav1.ObjectMeta
+	PodSelectorLabels map[string]string
+}
+
+// GetDeployment gets a deployment that runs the devfile's container components as a single pod,
+// built from the getContainer building block.
+func GetDeployment(devfileObj parser.DevfileObj, params DeploymentParams) (*appsv1.Deployment, error) {
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var containers []corev1.Container
+	for _, component := range components {
+		if component.Container == nil {
+			continue
+		}
+		containers = append(containers, *getContainer(containerParams{
+			Name:         component.Name,
+			Image:        component.Container.Image,
+			Command:      component.Container.Command,
+			Args:         component.Container.Args,
+			EnvVars:      convertEnvs(component.Container.Env),
+			ResourceReqs: getResourceReqs(component),
+			Ports:        convertPorts(component.Container.Endpoints),
+		}))
+	}
+
+	replicas := int32(1)
+	deployment := &appsv1.Deployment{
+		TypeMeta:   params.TypeMeta,
+		ObjectMeta: params.ObjectMeta,
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: params.PodSelectorLabels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: params.PodSelectorLabels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: containers,
+				},
+			},
+		},
+	}
+
+	return deployment, nil
+}
+
+// ServiceParams is a struct that contains the required data to create a service object
+type ServiceParams struct {
+	TypeMeta       metav1.TypeMeta
+	ObjectMeta     metav1.ObjectMeta
+	SelectorLabels map[string]string
+}
+
+// GetService gets a service that exposes every container endpoint of the devfile components,
+// built on top of getServiceSpec.
+func GetService(devfileObj parser.DevfileObj, params ServiceParams) (*corev1.Service, error) {
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var containerPorts []corev1.ContainerPort
+	for _, component := range components {
+		if component.Container == nil {
+			continue
+		}
+		containerPorts = append(containerPorts, convertPorts(component.Container.Endpoints)...)
+	}
+
+	svcSpec := getServiceSpec(serviceSpecParams{
+		SelectorLabels: params.SelectorLabels,
+		ContainerPorts: containerPorts,
+	})
+
+	service := &corev1.Service{
+		TypeMeta:   params.TypeMeta,
+		ObjectMeta: params.ObjectMeta,
+		Spec:       *svcSpec,
+	}
+
+	return service, nil
+}
+
+// defaultIngressHostTemplate is used to derive a hostname for an endpoint when
+// IngressParams.HostTemplate is left empty
+const defaultIngressHostTemplate = "{{.EndpointName}}-{{.ComponentName}}.example.com"
+
+// ingressHost is the data made available to HostTemplate when rendering a hostname
+type ingressHost struct {
+	EndpointName  string
+	ComponentName string
+}
+
+// IngressParams is a struct that contains the required data to create an ingress object
+type IngressParams struct {
+	TypeMeta         metav1.TypeMeta
+	ObjectMeta       metav1.ObjectMeta
+	IngressClassName string
+	TLSSecretName    string
+	// ServiceName is the name of the Service (e.g. produced by GetService) that the
+	// generated rules route to. Defaults to ObjectMeta.Name when left empty, which
+	// only works if the Ingress and the Service it targets share the same name.
+	ServiceName string
+	// HostTemplate is a Go text/template string rendered with EndpointName and ComponentName
+	// to derive the host for each public endpoint. Defaults to defaultIngressHostTemplate.
+	HostTemplate string
+}
+
+// GetIngress gets an ingress that exposes the public container endpoints of the devfile
+// components, mapping each one to the service port produced by getServiceSpec.
+func GetIngress(devfileObj parser.DevfileObj, params IngressParams) (*networkingv1.Ingress, error) {
+	hostTemplate := params.HostTemplate
+	if hostTemplate == "" {
+		hostTemplate = defaultIngressHostTemplate
+	}
+	tmpl, err := template.New("ingressHost").Parse(hostTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := params.ServiceName
+	if serviceName == "" {
+		serviceName = params.ObjectMeta.Name
+	}
+
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []networkingv1.IngressRule
+	var hosts []string
+	for _, component := range components {
+		if component.Container == nil {
+			continue
+		}
+		containerPorts := convertPorts(component.Container.Endpoints)
+		for i, endpoint := range component.Container.Endpoints {
+			if endpoint.Exposure != v1.Public && endpoint.Exposure != "" {
+				continue
+			}
+
+			var rendered bytes.Buffer
+			err = tmpl.Execute(&rendered, ingressHost{EndpointName: endpoint.Name, ComponentName: component.Name})
+			if err != nil {
+				return nil, err
+			}
+			host := rendered.String()
+			hosts = append(hosts, host)
+
+			rules = append(rules, getIngressRule(host, serviceName, containerPorts[i].Name))
+		}
+	}
+
+	var tls []networkingv1.IngressTLS
+	if params.TLSSecretName != "" && len(hosts) > 0 {
+		tls = []networkingv1.IngressTLS{
+			{
+				Hosts:      hosts,
+				SecretName: params.TLSSecretName,
+			},
+		}
+	}
+
+	ingressSpec := getIngressSpec(ingressSpecParams{
+		IngressClassName: params.IngressClassName,
+		Rules:            rules,
+		TLS:              tls,
+	})
+
+	ingress := &networkingv1.Ingress{
+		TypeMeta:   params.TypeMeta,
+		ObjectMeta: params.ObjectMeta,
+		Spec:       *ingressSpec,
+	}
+
+	return ingress, nil
+}
@@ -0,0 +1,110 @@
+package generator
+
+import (
+	"testing"
+
+	v1 "github.com/devfile/api/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	"github.com/redhat-developer/devfile-parser/pkg/devfile/parser"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestDevfileObj(t *testing.T, components []v1.Component) parser.DevfileObj {
+	t.Helper()
+
+	devfileData, err := data.NewDevfileData("2.2.0")
+	if err != nil {
+		t.Fatalf("failed to create devfile data: %v", err)
+	}
+	if err := devfileData.AddComponents(components); err != nil {
+		t.Fatalf("failed to add components: %v", err)
+	}
+
+	return parser.DevfileObj{Data: devfileData}
+}
+
+func runtimeContainerComponent() v1.Component {
+	return v1.Component{
+		Name: "runtime",
+		ComponentUnion: v1.ComponentUnion{
+			Container: &v1.ContainerComponent{
+				Container: v1.Container{
+					Image: "quay.io/test/image:latest",
+					Endpoints: []v1.Endpoint{
+						{
+							Name:       "http",
+							TargetPort: 8080,
+							Exposure:   v1.Public,
+						},
+						{
+							Name:       "debug",
+							TargetPort: 5858,
+							Exposure:   v1.Internal,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetIngress(t *testing.T) {
+	tests := []struct {
+		name            string
+		params          IngressParams
+		wantServiceName string
+	}{
+		{
+			name: "ServiceName defaults to ObjectMeta.Name",
+			params: IngressParams{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-app"},
+			},
+			wantServiceName: "my-app",
+		},
+		{
+			name: "explicit ServiceName overrides ObjectMeta.Name",
+			params: IngressParams{
+				ObjectMeta:  metav1.ObjectMeta{Name: "my-app-ingress"},
+				ServiceName: "my-app-service",
+			},
+			wantServiceName: "my-app-service",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileObj := newTestDevfileObj(t, []v1.Component{runtimeContainerComponent()})
+
+			ingress, err := GetIngress(devfileObj, tt.params)
+			if err != nil {
+				t.Fatalf("GetIngress() unexpected error: %v", err)
+			}
+
+			// Only the "http" endpoint is Public; "debug" (Internal) must not get a rule.
+			if len(ingress.Spec.Rules) != 1 {
+				t.Fatalf("GetIngress() got %d rules, want 1", len(ingress.Spec.Rules))
+			}
+
+			backend := ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+			if backend.Name != tt.wantServiceName {
+				t.Errorf("GetIngress() rule targets service %q, want %q", backend.Name, tt.wantServiceName)
+			}
+		})
+	}
+}
+
+func TestGetIngressDefaultHostTemplate(t *testing.T) {
+	devfileObj := newTestDevfileObj(t, []v1.Component{runtimeContainerComponent()})
+
+	ingress, err := GetIngress(devfileObj, IngressParams{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app"},
+	})
+	if err != nil {
+		t.Fatalf("GetIngress() unexpected error: %v", err)
+	}
+
+	want := "http-runtime.example.com"
+	if got := ingress.Spec.Rules[0].Host; got != want {
+		t.Errorf("GetIngress() rule host = %q, want %q", got, want)
+	}
+}
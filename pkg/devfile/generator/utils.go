@@ -8,6 +8,7 @@ import (
 	v1 "github.com/devfile/api/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/util"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -167,4 +168,51 @@ func getServiceSpec(serviceSpecParams serviceSpecParams) *corev1.ServiceSpec {
 	}
 
 	return svcSpec
+}
+
+// ingressSpecParams is a struct that contains the required data to create an ingress spec object
+type ingressSpecParams struct {
+	IngressClassName string
+	Rules            []networkingv1.IngressRule
+	TLS              []networkingv1.IngressTLS
+}
+
+// getIngressSpec gets an ingress spec
+func getIngressSpec(ingressSpecParams ingressSpecParams) *networkingv1.IngressSpec {
+	ingressSpec := &networkingv1.IngressSpec{
+		Rules: ingressSpecParams.Rules,
+		TLS:   ingressSpecParams.TLS,
+	}
+
+	if ingressSpecParams.IngressClassName != "" {
+		ingressSpec.IngressClassName = &ingressSpecParams.IngressClassName
+	}
+
+	return ingressSpec
+}
+
+// getIngressRule gets an ingress rule that routes the given host to the named service port
+func getIngressRule(host, serviceName, servicePortName string) networkingv1.IngressRule {
+	pathType := networkingv1.PathTypePrefix
+	return networkingv1.IngressRule{
+		Host: host,
+		IngressRuleValue: networkingv1.IngressRuleValue{
+			HTTP: &networkingv1.HTTPIngressRuleValue{
+				Paths: []networkingv1.HTTPIngressPath{
+					{
+						Path:     "/",
+						PathType: &pathType,
+						Backend: networkingv1.IngressBackend{
+							Service: &networkingv1.IngressServiceBackend{
+								Name: serviceName,
+								Port: networkingv1.ServiceBackendPort{
+									Name: servicePortName,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
 }
\ No newline at end of file
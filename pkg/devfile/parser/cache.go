@@ -0,0 +1,129 @@
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CacheEntry records the digest of the cached content for a URL, plus the
+// HTTP validators needed to perform a conditional GET on the next parse.
+type CacheEntry struct {
+	Digest       string
+	ETag         string
+	LastModified string
+}
+
+// Cache caches the raw bytes fetched for a parent.Uri or plugin.Uri so that
+// repeated parses of devfiles sharing a parent don't re-download its content
+// on every call. A custom implementation can be installed per parse via
+// ParseOptions.ParentPluginCache.
+type Cache interface {
+	// Lookup returns the cache entry previously stored for url, if any.
+	Lookup(url string) (entry CacheEntry, ok bool, err error)
+	// Get returns the raw devfile content previously stored under digest.
+	Get(digest string) ([]byte, error)
+	// Put stores content under its sha256 digest and indexes url to entry.
+	Put(url string, content []byte, entry CacheEntry) error
+}
+
+// DefaultParentPluginCache is the Cache used by parseParentAndPlugin when a
+// parse isn't given its own via ParseOptions.ParentPluginCache. It persists
+// blobs on disk under $XDG_CACHE_HOME/devfile (or ~/.cache/devfile when unset).
+var DefaultParentPluginCache Cache = newDiskCache()
+
+// diskCache is a Cache backed by a content-addressable blob
+// store: blobs/sha256/<digest> holds the raw bytes, and refs/<sha256 of url>
+// holds the JSON-encoded CacheEntry most recently seen for that url.
+type diskCache struct {
+	baseDir string
+	mu      sync.Mutex
+}
+
+func newDiskCache() *diskCache {
+	return &diskCache{baseDir: cacheBaseDir()}
+}
+
+func cacheBaseDir() string {
+	if dir := os.Getenv("XDG_CACHE_HOME"); dir != "" {
+		return filepath.Join(dir, "devfile")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), "devfile")
+	}
+	return filepath.Join(home, ".cache", "devfile")
+}
+
+func digestOf(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *diskCache) blobPath(digest string) string {
+	return filepath.Join(c.baseDir, "blobs", "sha256", digest)
+}
+
+func (c *diskCache) refPath(url string) string {
+	return filepath.Join(c.baseDir, "refs", digestOf([]byte(url)))
+}
+
+func (c *diskCache) Lookup(url string) (CacheEntry, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	raw, err := ioutil.ReadFile(c.refPath(url))
+	if os.IsNotExist(err) {
+		return CacheEntry{}, false, nil
+	}
+	if err != nil {
+		return CacheEntry{}, false, err
+	}
+
+	var entry CacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return CacheEntry{}, false, err
+	}
+	return entry, true, nil
+}
+
+func (c *diskCache) Get(digest string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return ioutil.ReadFile(c.blobPath(digest))
+}
+
+func (c *diskCache) Put(url string, content []byte, entry CacheEntry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry.Digest = digestOf(content)
+
+	blobPath := c.blobPath(entry.Digest)
+	if err := os.MkdirAll(filepath.Dir(blobPath), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(blobPath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write cache blob for %s: %w", url, err)
+	}
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	refPath := c.refPath(url)
+	if err := os.MkdirAll(filepath.Dir(refPath), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(refPath, raw, 0644); err != nil {
+		return fmt.Errorf("failed to write cache ref for %s: %w", url, err)
+	}
+
+	return nil
+}
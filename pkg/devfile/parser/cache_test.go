@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"testing"
+)
+
+func TestDiskCachePutGetLookup(t *testing.T) {
+	cache := &diskCache{baseDir: t.TempDir()}
+
+	uri := "oci://registry.example.com/org/repo@sha256:deadbeef"
+	content := []byte("kind: Devfile\n")
+
+	if err := cache.Put(uri, content, CacheEntry{ETag: `"abc123"`}); err != nil {
+		t.Fatalf("Put() unexpected error: %v", err)
+	}
+
+	entry, ok, err := cache.Lookup(uri)
+	if err != nil {
+		t.Fatalf("Lookup() unexpected error: %v", err)
+	}
+	if !ok {
+		t.Fatalf("Lookup() = false, want true after Put")
+	}
+	if entry.Digest != digestOf(content) {
+		t.Errorf("Lookup() entry.Digest = %q, want %q", entry.Digest, digestOf(content))
+	}
+	if entry.ETag != `"abc123"` {
+		t.Errorf("Lookup() entry.ETag = %q, want %q", entry.ETag, `"abc123"`)
+	}
+
+	got, err := cache.Get(entry.Digest)
+	if err != nil {
+		t.Fatalf("Get() unexpected error: %v", err)
+	}
+	if string(got) != string(content) {
+		t.Errorf("Get() = %q, want %q", got, content)
+	}
+}
+
+func TestDiskCacheLookupMiss(t *testing.T) {
+	cache := &diskCache{baseDir: t.TempDir()}
+
+	_, ok, err := cache.Lookup("oci://registry.example.com/org/never-cached")
+	if err != nil {
+		t.Fatalf("Lookup() unexpected error: %v", err)
+	}
+	if ok {
+		t.Errorf("Lookup() = true, want false for a url that was never Put")
+	}
+}
+
+func TestDiskCacheGetMiss(t *testing.T) {
+	cache := &diskCache{baseDir: t.TempDir()}
+
+	if _, err := cache.Get(digestOf([]byte("never stored"))); err == nil {
+		t.Error("Get() expected error for a digest that was never Put, got nil")
+	}
+}
+
+func TestDiskCachePutOverwritesEntry(t *testing.T) {
+	cache := &diskCache{baseDir: t.TempDir()}
+	uri := "oci://registry.example.com/org/repo:latest"
+
+	if err := cache.Put(uri, []byte("v1"), CacheEntry{ETag: `"v1"`}); err != nil {
+		t.Fatalf("Put() unexpected error: %v", err)
+	}
+	if err := cache.Put(uri, []byte("v2"), CacheEntry{ETag: `"v2"`}); err != nil {
+		t.Fatalf("Put() unexpected error: %v", err)
+	}
+
+	entry, ok, err := cache.Lookup(uri)
+	if err != nil || !ok {
+		t.Fatalf("Lookup() = %v, %v, %v", entry, ok, err)
+	}
+	if entry.ETag != `"v2"` {
+		t.Errorf("Lookup() entry.ETag = %q, want %q after overwriting Put", entry.ETag, `"v2"`)
+	}
+
+	got, err := cache.Get(entry.Digest)
+	if err != nil {
+		t.Fatalf("Get() unexpected error: %v", err)
+	}
+	if string(got) != "v2" {
+		t.Errorf("Get() = %q, want %q", got, "v2")
+	}
+}
@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestParseOCIReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     string
+		want    ociReference
+		wantErr bool
+	}{
+		{
+			name: "tag",
+			uri:  "oci://registry.example.com/org/repo:v1.0.0",
+			want: ociReference{registry: "registry.example.com", repo: "org/repo", tag: "v1.0.0"},
+		},
+		{
+			name: "no tag or digest defaults to latest",
+			uri:  "oci://registry.example.com/org/repo",
+			want: ociReference{registry: "registry.example.com", repo: "org/repo", tag: "latest"},
+		},
+		{
+			name: "digest",
+			uri:  "oci://registry.example.com/org/repo@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			want: ociReference{registry: "registry.example.com", repo: "org/repo", digest: "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		},
+		{
+			name: "registry with port and digest",
+			uri:  "oci://registry.example.com:5000/org/repo@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			want: ociReference{registry: "registry.example.com:5000", repo: "org/repo", digest: "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		},
+		{
+			name: "nested repo path with tag",
+			uri:  "oci://registry.example.com/org/team/repo:v1.0.0",
+			want: ociReference{registry: "registry.example.com", repo: "org/team/repo", tag: "v1.0.0"},
+		},
+		{
+			name:    "missing repo",
+			uri:     "oci://registry.example.com",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOCIReference(tt.uri)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseOCIReference() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parseOCIReference() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOCIReferenceTagOrDigest(t *testing.T) {
+	ref := ociReference{tag: "latest", digest: "sha256:abc"}
+	if got := ref.tagOrDigest(); got != "sha256:abc" {
+		t.Errorf("tagOrDigest() = %q, want digest to take priority over tag", got)
+	}
+
+	ref = ociReference{tag: "v1.0.0"}
+	if got := ref.tagOrDigest(); got != "v1.0.0" {
+		t.Errorf("tagOrDigest() = %q, want %q", got, "v1.0.0")
+	}
+}
+
+func TestVerifyLayerDigest(t *testing.T) {
+	content := []byte("schemaVersion: 2.2.0\n")
+	layer := ocispec.Descriptor{Digest: digest.FromBytes(content)}
+
+	if err := verifyLayerDigest(content, layer); err != nil {
+		t.Errorf("verifyLayerDigest() unexpected error for matching content: %v", err)
+	}
+
+	if err := verifyLayerDigest([]byte("tampered content"), layer); err == nil {
+		t.Error("verifyLayerDigest() expected an error for mismatched content, got nil")
+	}
+}
@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// defaultMaxConcurrentFetches is used when ParseOptions.MaxConcurrentFetches
+// is left at its zero value.
+const defaultMaxConcurrentFetches = 8
+
+// Source identifies the devfile to parse. Set one field: Path for a local
+// file, URL for an HTTP(S) location, Data for an already-read byte slice, or
+// Reader for a stream (e.g. stdin). Leaving all of them unset is an error;
+// when more than one is set, the first in the order Path, URL, Data, Reader
+// is used and the rest are ignored.
+type Source struct {
+	Path   string
+	URL    string
+	Data   []byte
+	Reader io.Reader
+}
+
+// ParseOptions carries the optional, per-call knobs accepted by Parse,
+// ParseRawDevfile, ParseFromURL, ParseFromData and ParseWithOptions. The zero
+// value is valid; unset fields fall back to their defaults.
+type ParseOptions struct {
+	// Context bounds and can cancel any parent/plugin fetches made while
+	// resolving this devfile. Defaults to context.Background().
+	Context context.Context
+	// HTTPClient is used for HTTP(S) parent/plugin fetches. Defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+	// Flatten controls whether parent/plugin references are resolved and
+	// merged into the returned DevfileObj. Only consulted by ParseWithOptions;
+	// Parse/ParseFromURL/ParseFromData always flatten and ParseRawDevfile never does.
+	Flatten bool
+	// MaxConcurrentFetches caps how many parent/plugin URIs are resolved
+	// concurrently. Defaults to defaultMaxConcurrentFetches.
+	MaxConcurrentFetches int
+	// URIResolvers overrides the globally registered URIResolver for a
+	// scheme (see RegisterURIResolver). Schemes absent here fall back to the
+	// global registry.
+	URIResolvers map[string]URIResolver
+	// ParentPluginCache overrides DefaultParentPluginCache for this parse.
+	ParentPluginCache Cache
+}
+
+// withDefaults returns a copy of o with zero-valued fields replaced by their
+// defaults.
+func (o ParseOptions) withDefaults() ParseOptions {
+	if o.Context == nil {
+		o.Context = context.Background()
+	}
+	if o.HTTPClient == nil {
+		o.HTTPClient = http.DefaultClient
+	}
+	if o.MaxConcurrentFetches <= 0 {
+		o.MaxConcurrentFetches = defaultMaxConcurrentFetches
+	}
+	if o.ParentPluginCache == nil {
+		o.ParentPluginCache = DefaultParentPluginCache
+	}
+	return o
+}
+
+// resolveOptions returns the first of a variadic ParseOptions list, filled in
+// with defaults, or the zero value's defaults when none was passed.
+func resolveOptions(options []ParseOptions) ParseOptions {
+	if len(options) == 0 {
+		return ParseOptions{}.withDefaults()
+	}
+	return options[0].withDefaults()
+}
@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseWithOptionsRejectsEmptySource(t *testing.T) {
+	_, err := ParseWithOptions(Source{}, ParseOptions{})
+	if err == nil {
+		t.Fatal("ParseWithOptions() expected an error for an empty Source, got nil")
+	}
+	want := "must set exactly one of Path, URL, Data or Reader"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("ParseWithOptions() error = %q, want it to contain %q", err.Error(), want)
+	}
+}
+
+func TestParseWithOptionsPrefersPathOverOtherSourceFields(t *testing.T) {
+	// Source dispatches in Path > URL > Data > Reader priority order; a bogus
+	// Path must be the one that's tried (and fails) rather than silently
+	// falling through to URL.
+	_, err := ParseWithOptions(Source{
+		Path: "/nonexistent/path/to/a/devfile.yaml",
+		URL:  "http://example.invalid/devfile.yaml",
+	}, ParseOptions{})
+	if err == nil {
+		t.Fatal("ParseWithOptions() expected an error for a nonexistent Path, got nil")
+	}
+	if strings.Contains(err.Error(), "must set exactly one of") {
+		t.Errorf("ParseWithOptions() error = %q, want a Path-read error, not the empty-Source error", err.Error())
+	}
+}
+
+func TestWithDefaultsFillsZeroValues(t *testing.T) {
+	opts := ParseOptions{}.withDefaults()
+
+	if opts.Context == nil {
+		t.Error("withDefaults() left Context nil")
+	}
+	if opts.HTTPClient == nil {
+		t.Error("withDefaults() left HTTPClient nil")
+	}
+	if opts.MaxConcurrentFetches != defaultMaxConcurrentFetches {
+		t.Errorf("withDefaults() MaxConcurrentFetches = %d, want %d", opts.MaxConcurrentFetches, defaultMaxConcurrentFetches)
+	}
+	if opts.ParentPluginCache == nil {
+		t.Error("withDefaults() left ParentPluginCache nil")
+	}
+}
+
+func TestWithDefaultsPreservesSetValues(t *testing.T) {
+	cache := &diskCache{baseDir: t.TempDir()}
+	opts := ParseOptions{MaxConcurrentFetches: 3, ParentPluginCache: cache}.withDefaults()
+
+	if opts.MaxConcurrentFetches != 3 {
+		t.Errorf("withDefaults() MaxConcurrentFetches = %d, want 3", opts.MaxConcurrentFetches)
+	}
+	if opts.ParentPluginCache != cache {
+		t.Error("withDefaults() replaced an explicitly set ParentPluginCache")
+	}
+}
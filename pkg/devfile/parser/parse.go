@@ -1,11 +1,15 @@
 package parser
 
 import (
+	"context"
 	"encoding/json"
+	"io/ioutil"
+	"net/http"
 
 	devfileCtx "github.com/devfile/library/pkg/devfile/parser/context"
 	"github.com/devfile/library/pkg/devfile/parser/data"
 	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	"golang.org/x/sync/errgroup"
 	"k8s.io/klog"
 
 	"reflect"
@@ -17,7 +21,14 @@ import (
 
 // ParseDevfile func validates the devfile integrity.
 // Creates devfile context and runtime objects
-func parseDevfile(d DevfileObj, flattenedDevfile bool) (DevfileObj, error) {
+func parseDevfile(d DevfileObj, flattenedDevfile bool, opts ParseOptions) (DevfileObj, error) {
+	return parseDevfileWithVisited(d, flattenedDevfile, map[string]bool{}, opts)
+}
+
+// parseDevfileWithVisited is parseDevfile's internal implementation. visited
+// tracks the parent/plugin URIs already being resolved on the current parse
+// stack so that reference cycles can be detected.
+func parseDevfileWithVisited(d DevfileObj, flattenedDevfile bool, visited map[string]bool, opts ParseOptions) (DevfileObj, error) {
 
 	// Validate devfile
 	err := d.Ctx.Validate()
@@ -38,7 +49,7 @@ func parseDevfile(d DevfileObj, flattenedDevfile bool) (DevfileObj, error) {
 	}
 
 	if flattenedDevfile {
-		err = parseParentAndPlugin(d)
+		err = parseParentAndPlugin(d, visited, opts)
 		if err != nil {
 			return DevfileObj{}, err
 		}
@@ -48,113 +59,284 @@ func parseDevfile(d DevfileObj, flattenedDevfile bool) (DevfileObj, error) {
 	return d, nil
 }
 
-// Parse func populates the flattened devfile data, parses and validates the devfile integrity.
-// Creates devfile context and runtime objects
-func Parse(path string) (d DevfileObj, err error) {
-
-	// NewDevfileCtx
-	d.Ctx = devfileCtx.NewDevfileCtx(path)
+// ParseWithOptions parses and validates the devfile read from src, flattening
+// parent/plugin references into it when opts.Flatten is set. It is the single
+// seam Parse, ParseRawDevfile, ParseFromURL and ParseFromData are built on top
+// of; prefer it directly when src is an io.Reader or opts needs more than the
+// defaults.
+func ParseWithOptions(src Source, opts ParseOptions) (d DevfileObj, err error) {
+	opts = opts.withDefaults()
 
-	// Fill the fields of DevfileCtx struct
-	err = d.Ctx.Populate()
+	switch {
+	case src.Path != "":
+		d.Ctx = devfileCtx.NewDevfileCtx(src.Path)
+		err = d.Ctx.Populate()
+	case src.URL != "":
+		d.Ctx = devfileCtx.NewURLDevfileCtx(src.URL)
+		err = d.Ctx.PopulateFromURL()
+	case src.Data != nil:
+		d.Ctx = devfileCtx.DevfileCtx{}
+		if err = d.Ctx.SetDevfileContentFromBytes(src.Data); err == nil {
+			err = d.Ctx.PopulateFromRaw()
+		}
+	case src.Reader != nil:
+		var content []byte
+		if content, err = ioutil.ReadAll(src.Reader); err == nil {
+			d.Ctx = devfileCtx.DevfileCtx{}
+			if err = d.Ctx.SetDevfileContentFromBytes(content); err == nil {
+				err = d.Ctx.PopulateFromRaw()
+			}
+		}
+	default:
+		err = errors.New("parser: Source must set exactly one of Path, URL, Data or Reader")
+	}
 	if err != nil {
 		return d, err
 	}
-	return parseDevfile(d, true)
+
+	return parseDevfile(d, opts.Flatten, opts)
+}
+
+// Parse func populates the flattened devfile data, parses and validates the devfile integrity.
+// Creates devfile context and runtime objects. An optional ParseOptions can be passed to bound
+// and cancel parent/plugin fetches, or to cap how many of them run concurrently.
+func Parse(path string, options ...ParseOptions) (d DevfileObj, err error) {
+	opts := resolveOptions(options)
+	opts.Flatten = true
+	return ParseWithOptions(Source{Path: path}, opts)
 }
 
 // ParseRawDevfile populates the raw devfile data witout overring and merging
-func ParseRawDevfile(path string) (d DevfileObj, err error) {
-	// NewDevfileCtx
-	d.Ctx = devfileCtx.NewDevfileCtx(path)
+func ParseRawDevfile(path string, options ...ParseOptions) (d DevfileObj, err error) {
+	opts := resolveOptions(options)
+	opts.Flatten = false
+	return ParseWithOptions(Source{Path: path}, opts)
+}
+
+// ParseFromURL func parses and validates the devfile integrity.
+// Creates devfile context and runtime objects
+func ParseFromURL(url string, options ...ParseOptions) (d DevfileObj, err error) {
+	opts := resolveOptions(options)
+	opts.Flatten = true
+	return ParseWithOptions(Source{URL: url}, opts)
+}
+
+// ParseFromData func parses and validates the devfile integrity.
+// Creates devfile context and runtime objects
+func ParseFromData(data []byte, options ...ParseOptions) (d DevfileObj, err error) {
+	opts := resolveOptions(options)
+	opts.Flatten = true
+	return ParseWithOptions(Source{Data: data}, opts)
+}
+
+// resolveParentOrPlugin fetches the devfile at uri - using the content-addressable
+// cache to skip the download when a conditional GET reports the content hasn't
+// changed - and parses it, threading visited through so that cycles across the
+// parent/plugin chain are detected.
+func resolveParentOrPlugin(ctx context.Context, uri string, visited map[string]bool, opts ParseOptions) (DevfileObj, error) {
+	if visited[uri] {
+		return DevfileObj{}, errors.Errorf("cycle detected while resolving parent/plugin reference %q", uri)
+	}
 
-	// Fill the fields of DevfileCtx struct
-	err = d.Ctx.Populate()
+	content, err := fetchContent(ctx, uri, opts)
 	if err != nil {
-		return d, err
+		return DevfileObj{}, err
+	}
+
+	d, err := parseFromData(content)
+	if err != nil {
+		return DevfileObj{}, err
+	}
+
+	nextVisited := make(map[string]bool, len(visited)+1)
+	for k := range visited {
+		nextVisited[k] = true
 	}
-	return parseDevfile(d, false)
+	nextVisited[uri] = true
+
+	nextOpts := opts
+	nextOpts.Context = ctx
+
+	return parseDevfileWithVisited(d, true, nextVisited, nextOpts)
 }
 
-// ParseFromURL func parses and validates the devfile integrity.
-// Creates devfile context and runtime objects
-func ParseFromURL(url string) (d DevfileObj, err error) {
-	d.Ctx = devfileCtx.NewURLDevfileCtx(url)
+// fetchWithCache returns the raw devfile content at uri, consulting
+// opts.ParentPluginCache first. When the cache already holds an entry for
+// uri, the request is revalidated with If-None-Match/If-Modified-Since and a
+// 304 response serves the cached blob instead of the downloaded body.
+func fetchWithCache(ctx context.Context, uri string, opts ParseOptions) ([]byte, error) {
+	cache := opts.ParentPluginCache
 
-	// Fill the fields of DevfileCtx struct
-	err = d.Ctx.PopulateFromURL()
+	entry, ok, err := cache.Lookup(uri)
 	if err != nil {
-		return d, err
+		return nil, errors.Wrapf(err, "failed to look up cache entry for %s", uri)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build request for %s", uri)
+	}
+	if ok {
+		if entry.ETag != "" {
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			req.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	resp, err := opts.HTTPClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch %s", uri)
+	}
+	defer resp.Body.Close()
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		klog.V(4).Infof("cache hit for %v, skipping download", uri)
+		return cache.Get(entry.Digest)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to fetch %s, received status code %v", uri, resp.StatusCode)
 	}
-	return parseDevfile(d, true)
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read response body for %s", uri)
+	}
+
+	newEntry := CacheEntry{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+	if err := cache.Put(uri, content, newEntry); err != nil {
+		klog.V(4).Infof("failed to cache content for %v: %v", uri, err)
+	}
+
+	return content, nil
 }
 
-// ParseFromData func parses and validates the devfile integrity.
-// Creates devfile context and runtime objects
-func ParseFromData(data []byte) (d DevfileObj, err error) {
+// parseFromData populates a DevfileObj's context from raw devfile bytes
+// without flattening it; the caller is responsible for flattening with the
+// appropriate visited set.
+func parseFromData(data []byte) (d DevfileObj, err error) {
 	d.Ctx = devfileCtx.DevfileCtx{}
 	err = d.Ctx.SetDevfileContentFromBytes(data)
 	if err != nil {
 		return d, errors.Wrap(err, "failed to set devfile content from bytes")
 	}
 	err = d.Ctx.PopulateFromRaw()
-	if err != nil {
-		return d, err
-	}
+	return d, err
+}
 
-	return parseDevfile(d, true)
+// parentOrPluginJob is a single parent.Uri or plugin.Uri fetch dispatched by
+// parseParentAndPlugin's worker pool. pluginIndex is -1 for the parent's job,
+// and otherwise the index of the owning component in pluginComponents, so
+// that results can be written back in the original, order-sensitive slot.
+type parentOrPluginJob struct {
+	uri         string
+	pluginIndex int
 }
 
-func parseParentAndPlugin(d DevfileObj) (err error) {
-	flattenedParent := &v1.DevWorkspaceTemplateSpecContent{}
-	if d.Data.GetParent() != nil {
-		if !reflect.DeepEqual(d.Data.GetParent(), &v1.Parent{}) && d.Data.GetParent().Uri != "" {
-			parent := d.Data.GetParent()
+const parentJobIndex = -1
 
-			parentData, err := ParseFromURL(parent.Uri)
-			if err != nil {
-				return err
+// resolveJobs resolves jobs with a worker pool bounded by
+// opts.MaxConcurrentFetches, writing each result back into its own
+// pluginIndex-addressed slot regardless of completion order, and cancelling
+// the remaining jobs as soon as one fails.
+func resolveJobs(ctx context.Context, jobs []parentOrPluginJob, numPlugins int, visited map[string]bool, opts ParseOptions) (parentResult DevfileObj, pluginResults []DevfileObj, err error) {
+	pluginResults = make([]DevfileObj, numPlugins)
+
+	g, ctx := errgroup.WithContext(ctx)
+	sem := make(chan struct{}, opts.MaxConcurrentFetches)
+	for _, job := range jobs {
+		job := job
+		g.Go(func() error {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
 			}
+			defer func() { <-sem }()
 
-			parentWorkspaceContent := parentData.Data.GetDevfileWorkspace()
-			if !reflect.DeepEqual(parent.ParentOverrides, v1.ParentOverrides{}) {
-				flattenedParent, err = apiOverride.OverrideDevWorkspaceTemplateSpec(parentWorkspaceContent, parent.ParentOverrides)
-				if err != nil {
-					return err
-				}
-			} else {
-				flattenedParent = parentWorkspaceContent
+			resolved, resolveErr := resolveParentOrPlugin(ctx, job.uri, visited, opts)
+			if resolveErr != nil {
+				return errors.Wrapf(resolveErr, "failed to resolve %s", job.uri)
 			}
 
-			klog.V(4).Infof("adding data of devfile with URI: %v", parent.Uri)
-		}
+			if job.pluginIndex == parentJobIndex {
+				parentResult = resolved
+			} else {
+				pluginResults[job.pluginIndex] = resolved
+			}
+			return nil
+		})
 	}
-	plugins := []*v1.DevWorkspaceTemplateSpecContent{}
+	err = g.Wait()
+	return parentResult, pluginResults, err
+}
+
+func parseParentAndPlugin(d DevfileObj, visited map[string]bool, opts ParseOptions) (err error) {
+	parent := d.Data.GetParent()
+	hasParent := parent != nil && !reflect.DeepEqual(parent, &v1.Parent{}) && parent.Uri != ""
+
 	components, err := d.Data.GetComponents(common.DevfileOptions{})
 	if err != nil {
 		return err
 	}
+
+	var pluginComponents []v1.Component
+	var jobs []parentOrPluginJob
+	if hasParent {
+		jobs = append(jobs, parentOrPluginJob{uri: parent.Uri, pluginIndex: parentJobIndex})
+	}
 	for _, component := range components {
-		if component.Plugin != nil && !reflect.DeepEqual(component.Plugin, &v1.PluginComponent{}) {
-			plugin := component.Plugin
-			var pluginData DevfileObj
-			if plugin.Uri != "" {
-				pluginData, err = ParseFromURL(plugin.Uri)
-				if err != nil {
-					return err
-				}
+		if component.Plugin == nil || reflect.DeepEqual(component.Plugin, &v1.PluginComponent{}) {
+			continue
+		}
+		if component.Plugin.Uri != "" {
+			jobs = append(jobs, parentOrPluginJob{uri: component.Plugin.Uri, pluginIndex: len(pluginComponents)})
+		}
+		pluginComponents = append(pluginComponents, component)
+	}
+
+	parentResult, pluginResults, err := resolveJobs(opts.Context, jobs, len(pluginComponents), visited, opts)
+	if err != nil {
+		return err
+	}
+
+	flattenedParent := &v1.DevWorkspaceTemplateSpecContent{}
+	if hasParent {
+		parentWorkspaceContent := parentResult.Data.GetDevfileWorkspace()
+		if !reflect.DeepEqual(parent.ParentOverrides, v1.ParentOverrides{}) {
+			flattenedParent, err = apiOverride.OverrideDevWorkspaceTemplateSpec(parentWorkspaceContent, parent.ParentOverrides)
+			if err != nil {
+				return err
 			}
-			pluginWorkspaceContent := pluginData.Data.GetDevfileWorkspace()
-			result := pluginWorkspaceContent
-			if !reflect.DeepEqual(plugin.PluginOverrides, v1.PluginOverrides{}) {
-				result, err = apiOverride.OverrideDevWorkspaceTemplateSpec(pluginWorkspaceContent, plugin.PluginOverrides)
-				if err != nil {
-					return err
-				}
+		} else {
+			flattenedParent = parentWorkspaceContent
+		}
+
+		klog.V(4).Infof("adding data of devfile with URI: %v", parent.Uri)
+	}
+
+	plugins := []*v1.DevWorkspaceTemplateSpecContent{}
+	for i, component := range pluginComponents {
+		plugin := component.Plugin
+		var pluginWorkspaceContent *v1.DevWorkspaceTemplateSpecContent
+		if plugin.Uri != "" {
+			pluginWorkspaceContent = pluginResults[i].Data.GetDevfileWorkspace()
+		}
+		result := pluginWorkspaceContent
+		if !reflect.DeepEqual(plugin.PluginOverrides, v1.PluginOverrides{}) {
+			result, err = apiOverride.OverrideDevWorkspaceTemplateSpec(pluginWorkspaceContent, plugin.PluginOverrides)
+			if err != nil {
+				return err
 			}
-			plugins = append(plugins, result)
 		}
+		plugins = append(plugins, result)
 	}
+
 	mergedContent, err := apiOverride.MergeDevWorkspaceTemplateSpec(d.Data.GetDevfileWorkspace(), flattenedParent, plugins...)
 	if err != nil {
 		return err
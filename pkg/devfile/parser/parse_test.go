@@ -0,0 +1,59 @@
+package parser
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestResolveParentOrPluginDetectsCycle(t *testing.T) {
+	uri := "https://example.com/base-devfile.yaml"
+	visited := map[string]bool{uri: true}
+
+	_, err := resolveParentOrPlugin(context.Background(), uri, visited, ParseOptions{}.withDefaults())
+	if err == nil {
+		t.Fatal("resolveParentOrPlugin() expected a cycle error, got nil")
+	}
+	if !strings.Contains(err.Error(), "cycle detected") {
+		t.Errorf("resolveParentOrPlugin() error = %q, want it to mention a cycle", err.Error())
+	}
+}
+
+func TestFetchWithCacheServesCachedContentOn304(t *testing.T) {
+	const content = "schemaVersion: 2.2.0\n"
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(content))
+	}))
+	defer server.Close()
+
+	opts := ParseOptions{ParentPluginCache: &diskCache{baseDir: t.TempDir()}}.withDefaults()
+
+	got, err := fetchWithCache(context.Background(), server.URL, opts)
+	if err != nil {
+		t.Fatalf("fetchWithCache() first call unexpected error: %v", err)
+	}
+	if string(got) != content {
+		t.Fatalf("fetchWithCache() first call = %q, want %q", got, content)
+	}
+
+	got, err = fetchWithCache(context.Background(), server.URL, opts)
+	if err != nil {
+		t.Fatalf("fetchWithCache() second call unexpected error: %v", err)
+	}
+	if string(got) != content {
+		t.Errorf("fetchWithCache() second call = %q, want %q", got, content)
+	}
+	if requests != 2 {
+		t.Errorf("server got %d requests, want 2 (one full fetch, one revalidation)", requests)
+	}
+}
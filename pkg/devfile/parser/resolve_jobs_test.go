@@ -0,0 +1,107 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// delayedResolver resolves uri after waiting delay (or ctx cancellation,
+// whichever comes first), returning content or err.
+type delayedResolver struct {
+	content []byte
+	delay   time.Duration
+	err     error
+}
+
+func (r *delayedResolver) Resolve(ctx context.Context, uri string) ([]byte, error) {
+	if r.delay > 0 {
+		select {
+		case <-time.After(r.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.content, nil
+}
+
+// resolveParentOrPlugin dispatches by the uri's scheme via fetchContent,
+// which keys resolvers by scheme, so each job below gets its own scheme to
+// target a distinct resolver with a distinct delay.
+func TestResolveJobsWritesResultsByPluginIndexRegardlessOfCompletionOrder(t *testing.T) {
+	content := func(name string) []byte {
+		return []byte(`{"schemaVersion":"2.2.0","metadata":{"name":"` + name + `"}}`)
+	}
+
+	// job 0 finishes last, job 2 finishes first - pluginResults must still be
+	// addressed by pluginIndex, not by completion order.
+	jobs := []parentOrPluginJob{
+		{uri: "slow://x", pluginIndex: 0},
+		{uri: "medium://x", pluginIndex: 1},
+		{uri: "fast://x", pluginIndex: 2},
+	}
+
+	opts := ParseOptions{
+		URIResolvers: map[string]URIResolver{
+			"slow":   &delayedResolver{content: content("slow"), delay: 30 * time.Millisecond},
+			"medium": &delayedResolver{content: content("medium"), delay: 15 * time.Millisecond},
+			"fast":   &delayedResolver{content: content("fast")},
+		},
+	}.withDefaults()
+
+	_, pluginResults, err := resolveJobs(context.Background(), jobs, 3, map[string]bool{}, opts)
+	if err != nil {
+		t.Fatalf("resolveJobs() unexpected error: %v", err)
+	}
+
+	wantNames := []string{"slow", "medium", "fast"}
+	for i, want := range wantNames {
+		got := string(pluginResults[i].Ctx.GetDevfileContent())
+		if !strings.Contains(got, `"name":"`+want+`"`) {
+			t.Errorf("pluginResults[%d] = %q, want content for %q", i, got, want)
+		}
+	}
+}
+
+func TestResolveJobsCancelsRemainingJobsOnFirstError(t *testing.T) {
+	observedCancel := make(chan struct{}, 1)
+
+	jobs := []parentOrPluginJob{
+		{uri: "failing://x", pluginIndex: 0},
+		{uri: "blocking://x", pluginIndex: 1},
+	}
+
+	opts := ParseOptions{
+		URIResolvers: map[string]URIResolver{
+			"failing":  &delayedResolver{err: errors.New("boom")},
+			"blocking": &cancelObservingResolver{observed: observedCancel},
+		},
+	}.withDefaults()
+
+	_, _, err := resolveJobs(context.Background(), jobs, 1, map[string]bool{}, opts)
+	if err == nil {
+		t.Fatal("resolveJobs() expected an error, got nil")
+	}
+
+	select {
+	case <-observedCancel:
+	case <-time.After(2 * time.Second):
+		t.Fatal("resolveJobs() did not cancel the in-flight job after a sibling job failed")
+	}
+}
+
+// cancelObservingResolver blocks until ctx is cancelled, then signals observed.
+type cancelObservingResolver struct {
+	observed chan<- struct{}
+}
+
+func (r *cancelObservingResolver) Resolve(ctx context.Context, uri string) ([]byte, error) {
+	<-ctx.Done()
+	r.observed <- struct{}{}
+	return nil, ctx.Err()
+}
@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+// URIResolver fetches the raw devfile content referenced by a parent.Uri or
+// plugin.Uri whose scheme isn't resolved by the built-in HTTP(S) fetch path,
+// e.g. "oci://", "git://", "s3://".
+type URIResolver interface {
+	Resolve(ctx context.Context, uri string) ([]byte, error)
+}
+
+var (
+	uriResolversMu sync.RWMutex
+	uriResolvers   = map[string]URIResolver{
+		"oci": &ociResolver{},
+	}
+)
+
+// RegisterURIResolver registers r as the resolver used for parent/plugin URIs
+// with the given scheme. Registering a scheme a second time replaces the
+// previously registered resolver.
+func RegisterURIResolver(scheme string, r URIResolver) {
+	uriResolversMu.Lock()
+	defer uriResolversMu.Unlock()
+	uriResolvers[scheme] = r
+}
+
+func lookupURIResolver(scheme string) (URIResolver, bool) {
+	uriResolversMu.RLock()
+	defer uriResolversMu.RUnlock()
+	r, ok := uriResolvers[scheme]
+	return r, ok
+}
+
+func schemeOf(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return ""
+	}
+	return parsed.Scheme
+}
+
+// fetchContent returns the raw devfile content at uri. HTTP(S) URIs go
+// through fetchWithCache's conditional-GET cache; any other scheme is first
+// looked up in opts.ParentPluginCache and, on a miss, routed to its resolver -
+// preferring one registered in opts.URIResolvers over the global registry -
+// with the result then stored in opts.ParentPluginCache keyed by uri.
+func fetchContent(ctx context.Context, uri string, opts ParseOptions) ([]byte, error) {
+	switch scheme := schemeOf(uri); scheme {
+	case "http", "https", "":
+		return fetchWithCache(ctx, uri, opts)
+	default:
+		if entry, ok, err := opts.ParentPluginCache.Lookup(uri); err == nil && ok {
+			if content, err := opts.ParentPluginCache.Get(entry.Digest); err == nil {
+				klog.V(4).Infof("cache hit for %v, skipping resolver", uri)
+				return content, nil
+			}
+		}
+
+		resolver, ok := opts.URIResolvers[scheme]
+		if !ok {
+			resolver, ok = lookupURIResolver(scheme)
+		}
+		if !ok {
+			return nil, errors.Errorf("no URI resolver registered for scheme %q (uri: %s)", scheme, uri)
+		}
+
+		content, err := resolver.Resolve(ctx, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := opts.ParentPluginCache.Put(uri, content, CacheEntry{}); err != nil {
+			klog.V(4).Infof("failed to cache content for %v: %v", uri, err)
+		}
+
+		return content, nil
+	}
+}